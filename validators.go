@@ -0,0 +1,929 @@
+// Copyright (c) 2012-2016 The Revel Framework Authors, All rights reserved.
+// Revel Framework source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package revel
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+)
+
+// Validator is the interface that all validation rules must implement so
+// that they can be checked and report a default message on failure.
+type Validator interface {
+	IsSatisfied(interface{}) bool
+	DefaultMessage() string
+}
+
+// Required tests that the argument is non-nil and, for strings, bools, ints,
+// time.Time, slices, arrays and maps, non-zero or non-empty. Any other
+// non-nil value (a zero float or struct, for example) is satisfied.
+type Required struct{}
+
+func ValidRequired() Required {
+	return Required{}
+}
+
+func (r Required) IsSatisfied(obj interface{}) bool {
+	if obj == nil {
+		return false
+	}
+
+	if str, ok := obj.(string); ok {
+		return len(str) > 0
+	}
+	if b, ok := obj.(bool); ok {
+		return b
+	}
+	if i, ok := obj.(int); ok {
+		return i != 0
+	}
+	if t, ok := obj.(time.Time); ok {
+		return !t.IsZero()
+	}
+
+	v := reflect.ValueOf(obj)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len() > 0
+	}
+
+	return true
+}
+
+func (r Required) DefaultMessage() string {
+	return fmt.Sprintln("Required")
+}
+
+// NotBlank is like Required but additionally rejects strings that consist
+// entirely of whitespace, which Required alone treats as non-empty.
+type NotBlank struct{}
+
+func ValidNotBlank() NotBlank {
+	return NotBlank{}
+}
+
+func (r NotBlank) IsSatisfied(obj interface{}) bool {
+	if str, ok := obj.(string); ok {
+		return len(strings.TrimSpace(str)) > 0
+	}
+	return Required{}.IsSatisfied(obj)
+}
+
+func (r NotBlank) DefaultMessage() string {
+	return fmt.Sprintln("Required")
+}
+
+// numericValue extracts a float64 out of any Go numeric kind via reflection
+// so Min/Max/Range (and their Float variants) can compare int, uint, and
+// float inputs uniformly. Integer values whose magnitude exceeds 2^53 are
+// rounded to the nearest float64, so comparisons at that scale are inexact.
+func numericValue(obj interface{}) (float64, bool) {
+	v := reflect.ValueOf(obj)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// Min requires a number to be greater or equal to a given minimum. It
+// accepts any numeric kind (int/uint variants, float32/64), not just int.
+type Min struct {
+	Min int
+}
+
+func ValidMin(min int) Min {
+	return Min{min}
+}
+
+func (m Min) IsSatisfied(obj interface{}) bool {
+	num, ok := numericValue(obj)
+	if !ok {
+		return false
+	}
+	return num >= float64(m.Min)
+}
+
+func (m Min) DefaultMessage() string {
+	return fmt.Sprintf("Minimum is %d", m.Min)
+}
+
+// Max requires a number to be less or equal to a given maximum. It accepts
+// any numeric kind (int/uint variants, float32/64), not just int.
+type Max struct {
+	Max int
+}
+
+func ValidMax(max int) Max {
+	return Max{max}
+}
+
+func (m Max) IsSatisfied(obj interface{}) bool {
+	num, ok := numericValue(obj)
+	if !ok {
+		return false
+	}
+	return num <= float64(m.Max)
+}
+
+func (m Max) DefaultMessage() string {
+	return fmt.Sprintf("Maximum is %d", m.Max)
+}
+
+// Range requires a number to be within Min, Max inclusive.
+type Range struct {
+	Min
+	Max
+}
+
+func ValidRange(min, max int) Range {
+	return Range{Min{min}, Max{max}}
+}
+
+func (r Range) IsSatisfied(obj interface{}) bool {
+	return r.Min.IsSatisfied(obj) && r.Max.IsSatisfied(obj)
+}
+
+func (r Range) DefaultMessage() string {
+	return fmt.Sprintf("Range is %d to %d", r.Min.Min, r.Max.Max)
+}
+
+// FloatMin is like Min but takes a float64 bound, for thresholds that
+// aren't whole numbers.
+type FloatMin struct {
+	Min float64
+}
+
+func ValidFloatMin(min float64) FloatMin {
+	return FloatMin{min}
+}
+
+func (m FloatMin) IsSatisfied(obj interface{}) bool {
+	num, ok := numericValue(obj)
+	if !ok {
+		return false
+	}
+	return num >= m.Min
+}
+
+func (m FloatMin) DefaultMessage() string {
+	return fmt.Sprintf("Minimum is %v", m.Min)
+}
+
+// FloatMax is like Max but takes a float64 bound, for thresholds that
+// aren't whole numbers.
+type FloatMax struct {
+	Max float64
+}
+
+func ValidFloatMax(max float64) FloatMax {
+	return FloatMax{max}
+}
+
+func (m FloatMax) IsSatisfied(obj interface{}) bool {
+	num, ok := numericValue(obj)
+	if !ok {
+		return false
+	}
+	return num <= m.Max
+}
+
+func (m FloatMax) DefaultMessage() string {
+	return fmt.Sprintf("Maximum is %v", m.Max)
+}
+
+// FloatRange is like Range but takes float64 bounds, for thresholds that
+// aren't whole numbers.
+type FloatRange struct {
+	FloatMin
+	FloatMax
+}
+
+func ValidFloatRange(min, max float64) FloatRange {
+	return FloatRange{FloatMin{min}, FloatMax{max}}
+}
+
+func (r FloatRange) IsSatisfied(obj interface{}) bool {
+	return r.FloatMin.IsSatisfied(obj) && r.FloatMax.IsSatisfied(obj)
+}
+
+func (r FloatRange) DefaultMessage() string {
+	return fmt.Sprintf("Range is %v to %v", r.FloatMin.Min, r.FloatMax.Max)
+}
+
+// MinSize requires a slice or string to be at least a given length.
+type MinSize struct {
+	Min int
+}
+
+func ValidMinSize(min int) MinSize {
+	return MinSize{min}
+}
+
+func (m MinSize) IsSatisfied(obj interface{}) bool {
+	if str, ok := obj.(string); ok {
+		return utf8.RuneCountInString(str) >= m.Min
+	}
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Slice {
+		return v.Len() >= m.Min
+	}
+	return false
+}
+
+func (m MinSize) DefaultMessage() string {
+	return fmt.Sprintf("Minimum size is %d", m.Min)
+}
+
+// MaxSize requires a slice or string to be at most a given length.
+type MaxSize struct {
+	Max int
+}
+
+func ValidMaxSize(max int) MaxSize {
+	return MaxSize{max}
+}
+
+func (m MaxSize) IsSatisfied(obj interface{}) bool {
+	if str, ok := obj.(string); ok {
+		return utf8.RuneCountInString(str) <= m.Max
+	}
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Slice {
+		return v.Len() <= m.Max
+	}
+	return false
+}
+
+func (m MaxSize) DefaultMessage() string {
+	return fmt.Sprintf("Maximum size is %d", m.Max)
+}
+
+// Length requires a slice or string to be exactly a given length.
+type Length struct {
+	N int
+}
+
+func ValidLength(n int) Length {
+	return Length{n}
+}
+
+func (s Length) IsSatisfied(obj interface{}) bool {
+	if str, ok := obj.(string); ok {
+		return utf8.RuneCountInString(str) == s.N
+	}
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Slice {
+		return v.Len() == s.N
+	}
+	return false
+}
+
+func (s Length) DefaultMessage() string {
+	return fmt.Sprintf("Required length is %d", s.N)
+}
+
+// Match requires a string to match a given regex.
+type Match struct {
+	Regexp *regexp.Regexp
+}
+
+func ValidMatch(regex *regexp.Regexp) Match {
+	return Match{regex}
+}
+
+func (m Match) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	return m.Regexp.MatchString(str)
+}
+
+func (m Match) DefaultMessage() string {
+	return fmt.Sprintf("Must match %s", m.Regexp)
+}
+
+// emailPattern is a relatively strict, ASCII-only email matcher modeled on
+// the WHATWG HTML5 form validation regex.
+var emailPattern = regexp.MustCompile(`^[\w!#$%&'*+/=?^_` + "`" + `{|}~-]+(?:\.[\w!#$%&'*+/=?^_` + "`" + `{|}~-]+)*@(?:[\w](?:[\w-]*[\w])?\.)+[a-zA-Z0-9](?:[\w-]*[\w])?$`)
+
+// Email requires a string to be a valid email address.
+type Email struct {
+	Match
+}
+
+func ValidEmail() Email {
+	return Email{Match{emailPattern}}
+}
+
+func (e Email) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid email address")
+}
+
+// URL requires the value to be a string containing an absolute URL with a
+// non-empty scheme and host. Any scheme is accepted.
+type URL struct{}
+
+func ValidURL() URL {
+	return URL{}
+}
+
+func (u URL) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok || str == "" {
+		return false
+	}
+	parsed, err := url.Parse(str)
+	if err != nil {
+		return false
+	}
+	return parsed.Scheme != "" && parsed.Host != ""
+}
+
+func (u URL) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid URL")
+}
+
+// ipVersion selects which family of addresses an IP validator accepts.
+type ipVersion int
+
+const (
+	ipAny ipVersion = iota
+	ipv4Only
+	ipv6Only
+)
+
+// IP requires the value to be a string containing a valid IP address. Use
+// ValidIPv4/ValidIPv6 to restrict to a specific address family.
+type IP struct {
+	version ipVersion
+}
+
+func ValidIP() IP {
+	return IP{ipAny}
+}
+
+func ValidIPv4() IP {
+	return IP{ipv4Only}
+}
+
+func ValidIPv6() IP {
+	return IP{ipv6Only}
+}
+
+func (ip IP) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	parsed := net.ParseIP(str)
+	if parsed == nil {
+		return false
+	}
+	switch ip.version {
+	case ipv4Only:
+		return parsed.To4() != nil
+	case ipv6Only:
+		return parsed.To4() == nil && parsed.To16() != nil
+	default:
+		return true
+	}
+}
+
+func (ip IP) DefaultMessage() string {
+	switch ip.version {
+	case ipv4Only:
+		return fmt.Sprintln("Must be a valid IPv4 address")
+	case ipv6Only:
+		return fmt.Sprintln("Must be a valid IPv6 address")
+	default:
+		return fmt.Sprintln("Must be a valid IP address")
+	}
+}
+
+// CIDR requires the value to be a string in CIDR notation (e.g. 192.0.2.0/24).
+type CIDR struct{}
+
+func ValidCIDR() CIDR {
+	return CIDR{}
+}
+
+func (c CIDR) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	_, _, err := net.ParseCIDR(str)
+	return err == nil
+}
+
+func (c CIDR) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid CIDR notation")
+}
+
+// MAC requires the value to be a string containing a valid MAC (hardware)
+// address.
+type MAC struct{}
+
+func ValidMAC() MAC {
+	return MAC{}
+}
+
+func (m MAC) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	_, err := net.ParseMAC(str)
+	return err == nil
+}
+
+func (m MAC) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid MAC address")
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// UUID requires the value to be a string containing a valid UUID. A Version
+// of 0 (the ValidUUID default) accepts any version.
+type UUID struct {
+	Version int
+}
+
+func ValidUUID() UUID {
+	return UUID{0}
+}
+
+func ValidUUID3() UUID {
+	return UUID{3}
+}
+
+func ValidUUID4() UUID {
+	return UUID{4}
+}
+
+func ValidUUID5() UUID {
+	return UUID{5}
+}
+
+func (u UUID) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok || !uuidPattern.MatchString(str) {
+		return false
+	}
+	if u.Version == 0 {
+		return true
+	}
+	return str[14] == byte('0'+u.Version)
+}
+
+func (u UUID) DefaultMessage() string {
+	if u.Version == 0 {
+		return fmt.Sprintln("Must be a valid UUID")
+	}
+	return fmt.Sprintf("Must be a valid UUID version %d", u.Version)
+}
+
+// stripISBNSeparators removes the hyphens and spaces that commonly break up
+// an ISBN so the remaining digits can be checksummed.
+func stripISBNSeparators(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// ISBN10 requires the value to be a string containing a valid ISBN-10,
+// including a correct checksum digit.
+type ISBN10 struct{}
+
+func ValidISBN10() ISBN10 {
+	return ISBN10{}
+}
+
+func (i ISBN10) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	clean := stripISBNSeparators(str)
+	if len(clean) != 10 {
+		return false
+	}
+	sum := 0
+	for idx, c := range clean {
+		var digit int
+		switch {
+		case idx == 9 && (c == 'X' || c == 'x'):
+			digit = 10
+		case c >= '0' && c <= '9':
+			digit = int(c - '0')
+		default:
+			return false
+		}
+		sum += digit * (10 - idx)
+	}
+	return sum%11 == 0
+}
+
+func (i ISBN10) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid ISBN-10")
+}
+
+// ISBN13 requires the value to be a string containing a valid ISBN-13,
+// including a correct checksum digit.
+type ISBN13 struct{}
+
+func ValidISBN13() ISBN13 {
+	return ISBN13{}
+}
+
+func (i ISBN13) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	clean := stripISBNSeparators(str)
+	if len(clean) != 13 {
+		return false
+	}
+	sum := 0
+	for idx, c := range clean {
+		if c < '0' || c > '9' {
+			return false
+		}
+		digit := int(c - '0')
+		if idx%2 == 0 {
+			sum += digit
+		} else {
+			sum += digit * 3
+		}
+	}
+	return sum%10 == 0
+}
+
+func (i ISBN13) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid ISBN-13")
+}
+
+// ISBN requires the value to be a valid ISBN-10 or ISBN-13.
+type ISBN struct{}
+
+func ValidISBN() ISBN {
+	return ISBN{}
+}
+
+func (i ISBN) IsSatisfied(obj interface{}) bool {
+	return ISBN10{}.IsSatisfied(obj) || ISBN13{}.IsSatisfied(obj)
+}
+
+func (i ISBN) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid ISBN-10 or ISBN-13")
+}
+
+var (
+	asciiPattern          = regexp.MustCompile(`^[\x00-\x7F]*$`)
+	printableASCIIPattern = regexp.MustCompile(`^[\x20-\x7E]*$`)
+)
+
+// ASCII requires the value to be a string containing only ASCII characters.
+type ASCII struct{}
+
+func ValidASCII() ASCII {
+	return ASCII{}
+}
+
+func (a ASCII) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	return ok && asciiPattern.MatchString(str)
+}
+
+func (a ASCII) DefaultMessage() string {
+	return fmt.Sprintln("Must contain only ASCII characters")
+}
+
+// PrintableASCII requires the value to be a string containing only
+// printable ASCII characters (no control characters).
+type PrintableASCII struct{}
+
+func ValidPrintableASCII() PrintableASCII {
+	return PrintableASCII{}
+}
+
+func (p PrintableASCII) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	return ok && printableASCIIPattern.MatchString(str)
+}
+
+func (p PrintableASCII) DefaultMessage() string {
+	return fmt.Sprintln("Must contain only printable ASCII characters")
+}
+
+var base64Pattern = regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=|[A-Za-z0-9+/]{4})$`)
+
+// Base64 requires the value to be a string containing valid Base64-encoded
+// data.
+type Base64 struct{}
+
+func ValidBase64() Base64 {
+	return Base64{}
+}
+
+func (b Base64) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok || str == "" {
+		return false
+	}
+	return base64Pattern.MatchString(str)
+}
+
+func (b Base64) DefaultMessage() string {
+	return fmt.Sprintln("Must be valid Base64")
+}
+
+var dataURIPattern = regexp.MustCompile(`^data:(?:[\w.+-]+/[\w.+-]+)?(?:;[\w.-]+=[\w.-]+)*;base64,([A-Za-z0-9+/]*={0,2})$`)
+
+// DataURI requires the value to be a string containing a valid base64 data
+// URI (e.g. "data:image/png;base64,...").
+type DataURI struct{}
+
+func ValidDataURI() DataURI {
+	return DataURI{}
+}
+
+func (d DataURI) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	matches := dataURIPattern.FindStringSubmatch(str)
+	if matches == nil {
+		return false
+	}
+	return matches[1] == "" || Base64{}.IsSatisfied(matches[1])
+}
+
+func (d DataURI) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid data URI")
+}
+
+// toFloat converts any Go numeric kind (via numericValue) or a numeric
+// string to a float64.
+func toFloat(obj interface{}) (float64, bool) {
+	if s, ok := obj.(string); ok {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, false
+		}
+		return f, true
+	}
+	return numericValue(obj)
+}
+
+// Latitude requires the value to be a number or numeric string within
+// [-90, 90].
+type Latitude struct{}
+
+func ValidLatitude() Latitude {
+	return Latitude{}
+}
+
+func (l Latitude) IsSatisfied(obj interface{}) bool {
+	v, ok := toFloat(obj)
+	return ok && v >= -90 && v <= 90
+}
+
+func (l Latitude) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid latitude")
+}
+
+// Longitude requires the value to be a number or numeric string within
+// [-180, 180].
+type Longitude struct{}
+
+func ValidLongitude() Longitude {
+	return Longitude{}
+}
+
+func (l Longitude) IsSatisfied(obj interface{}) bool {
+	v, ok := toFloat(obj)
+	return ok && v >= -180 && v <= 180
+}
+
+func (l Longitude) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid longitude")
+}
+
+var ssnPattern = regexp.MustCompile(`^(\d{3})-?(\d{2})-?(\d{4})$`)
+
+// SSN requires the value to be a string containing a structurally valid US
+// Social Security Number (area/group/serial rules, not issuance records).
+type SSN struct{}
+
+func ValidSSN() SSN {
+	return SSN{}
+}
+
+func (s SSN) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	m := ssnPattern.FindStringSubmatch(str)
+	if m == nil {
+		return false
+	}
+	area, group, serial := m[1], m[2], m[3]
+	if area == "000" || area == "666" || area[0] == '9' {
+		return false
+	}
+	if group == "00" {
+		return false
+	}
+	if serial == "0000" {
+		return false
+	}
+	return true
+}
+
+func (s SSN) DefaultMessage() string {
+	return fmt.Sprintln("Must be a valid SSN")
+}
+
+// StartsWith requires a string to begin with a given prefix.
+type StartsWith struct {
+	Prefix string
+}
+
+func ValidStartsWith(prefix string) StartsWith {
+	return StartsWith{prefix}
+}
+
+func (s StartsWith) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	return strings.HasPrefix(str, s.Prefix)
+}
+
+func (s StartsWith) DefaultMessage() string {
+	return fmt.Sprintf("Must start with %s", s.Prefix)
+}
+
+// EndsWith requires a string to end with a given suffix.
+type EndsWith struct {
+	Suffix string
+}
+
+func ValidEndsWith(suffix string) EndsWith {
+	return EndsWith{suffix}
+}
+
+func (e EndsWith) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	return strings.HasSuffix(str, e.Suffix)
+}
+
+func (e EndsWith) DefaultMessage() string {
+	return fmt.Sprintf("Must end with %s", e.Suffix)
+}
+
+// Contains requires a string to contain a given substring.
+type Contains struct {
+	Substr string
+}
+
+func ValidContains(substr string) Contains {
+	return Contains{substr}
+}
+
+func (c Contains) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	return strings.Contains(str, c.Substr)
+}
+
+func (c Contains) DefaultMessage() string {
+	return fmt.Sprintf("Must contain %s", c.Substr)
+}
+
+// Excludes requires a string to not contain a given substring.
+type Excludes struct {
+	Substr string
+}
+
+func ValidExcludes(substr string) Excludes {
+	return Excludes{substr}
+}
+
+func (e Excludes) IsSatisfied(obj interface{}) bool {
+	str, ok := obj.(string)
+	if !ok {
+		return false
+	}
+	return !strings.Contains(str, e.Substr)
+}
+
+func (e Excludes) DefaultMessage() string {
+	return fmt.Sprintf("Must not contain %s", e.Substr)
+}
+
+// All requires every one of its child validators to be satisfied. It
+// short-circuits on the first failure and surfaces that validator's
+// DefaultMessage. The index of the failing validator is stored atomically,
+// so concurrent checks on one All do not race; DefaultMessage reports the
+// outcome of whichever check stored its index last.
+type All struct {
+	Validators []Validator
+	failedIdx  int32 // atomic; -1 once satisfied; ValidAll also starts it at -1, an All literal at 0
+}
+
+func ValidAll(validators ...Validator) *All {
+	return &All{Validators: validators, failedIdx: -1}
+}
+
+func (a *All) IsSatisfied(obj interface{}) bool {
+	for i, v := range a.Validators {
+		if !v.IsSatisfied(obj) {
+			atomic.StoreInt32(&a.failedIdx, int32(i))
+			return false
+		}
+	}
+	atomic.StoreInt32(&a.failedIdx, -1)
+	return true
+}
+
+func (a *All) DefaultMessage() string {
+	if idx := atomic.LoadInt32(&a.failedIdx); idx >= 0 && int(idx) < len(a.Validators) {
+		return a.Validators[idx].DefaultMessage()
+	}
+	return fmt.Sprintln("All conditions must be satisfied")
+}
+
+// Any requires at least one of its child validators to be satisfied. On
+// failure its message reports every child's DefaultMessage.
+type Any struct {
+	Validators []Validator
+}
+
+func ValidAny(validators ...Validator) *Any {
+	return &Any{Validators: validators}
+}
+
+func (a *Any) IsSatisfied(obj interface{}) bool {
+	for _, v := range a.Validators {
+		if v.IsSatisfied(obj) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Any) DefaultMessage() string {
+	messages := make([]string, len(a.Validators))
+	for i, v := range a.Validators {
+		messages[i] = v.DefaultMessage()
+	}
+	return strings.Join(messages, ", ")
+}
+
+// Not inverts the result of a single child validator.
+type Not struct {
+	Validator Validator
+}
+
+func ValidNot(v Validator) Not {
+	return Not{v}
+}
+
+func (n Not) IsSatisfied(obj interface{}) bool {
+	return !n.Validator.IsSatisfied(obj)
+}
+
+func (n Not) DefaultMessage() string {
+	return fmt.Sprintf("Must not satisfy: %s", n.Validator.DefaultMessage())
+}
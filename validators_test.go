@@ -58,12 +58,40 @@ func TestRequired(t *testing.T) {
 	}
 }
 
+func TestNotBlank(t *testing.T) {
+	tests := []Expect{
+		{nil, false, "nil data"},
+		{"Testing", true, "non-empty string"},
+		{"", false, "empty string"},
+		{" ", false, "whitespace-only string"},
+		{"\n", false, "newline-only string"},
+		{"\t　", false, "tabs and ideographic spaces"},
+		{" a ", true, "non-blank string with surrounding whitespace"},
+		{[]int{}, false, "empty slice"},
+		{[]int{1}, true, "non-empty slice"},
+		{map[string]int{}, false, "empty map"},
+		{map[string]int{"a": 1}, true, "non-empty map"},
+		{1, true, "positive integer"},
+		{0, false, "0 integer"},
+	}
+
+	for _, notBlank := range []NotBlank{{}, ValidNotBlank()} {
+		performTests(notBlank, tests, t)
+	}
+}
+
 func TestMin(t *testing.T) {
 	tests := []Expect{
 		{11, true, "val > min"},
 		{10, true, "val == min"},
 		{9, false, "val < min"},
 		{true, false, "TypeOf(val) != int"},
+		{10.5, true, "float64 val > min"},
+		{9.5, false, "float64 val < min"},
+		{int64(20), true, "int64 val > min"},
+		{int64(5), false, "int64 val < min"},
+		{uint(20), true, "uint val > min"},
+		{uint(5), false, "uint val < min"},
 	}
 	for _, min := range []Min{{10}, ValidMin(10)} {
 		performTests(min, tests, t)
@@ -76,12 +104,56 @@ func TestMax(t *testing.T) {
 		{10, true, "val == max"},
 		{11, false, "val > max"},
 		{true, false, "TypeOf(val) != int"},
+		{9.5, true, "float64 val < max"},
+		{10.5, false, "float64 val > max"},
+		{int64(5), true, "int64 val < max"},
+		{int64(20), false, "int64 val > max"},
+		{uint(5), true, "uint val < max"},
+		{uint(20), false, "uint val > max"},
 	}
 	for _, max := range []Max{{10}, ValidMax(10)} {
 		performTests(max, tests, t)
 	}
 }
 
+func TestFloatMin(t *testing.T) {
+	tests := []Expect{
+		{10.5, true, "val == min"},
+		{10.4, false, "val < min"},
+		{11, true, "int val > min"},
+		{int64(11), true, "int64 val > min"},
+		{true, false, "TypeOf(val) != numeric"},
+	}
+	for _, min := range []FloatMin{{10.5}, ValidFloatMin(10.5)} {
+		performTests(min, tests, t)
+	}
+}
+
+func TestFloatMax(t *testing.T) {
+	tests := []Expect{
+		{10.5, true, "val == max"},
+		{10.6, false, "val > max"},
+		{10, true, "int val < max"},
+		{uint(10), true, "uint val < max"},
+		{true, false, "TypeOf(val) != numeric"},
+	}
+	for _, max := range []FloatMax{{10.5}, ValidFloatMax(10.5)} {
+		performTests(max, tests, t)
+	}
+}
+
+func TestFloatRange(t *testing.T) {
+	tests := []Expect{
+		{10.5, true, "min <= val <= max"},
+		{10.4, false, "val < min"},
+		{20.1, false, "val > max"},
+		{15, true, "int val within range"},
+	}
+	for _, r := range []FloatRange{{FloatMin{10.5}, FloatMax{20}}, ValidFloatRange(10.5, 20)} {
+		performTests(r, tests, t)
+	}
+}
+
 func TestRange(t *testing.T) {
 	tests := []Expect{
 		{50, true, "min <= val <= max"},
@@ -113,8 +185,8 @@ func TestRange(t *testing.T) {
 		performTests(rangeValidator, tests, t)
 	}
 
-	tests = make([]Expect, 7)
-	for i, num := range []int{50, 100, 10, 9, 101, 0, -1} {
+	tests = make([]Expect, 9)
+	for i, num := range []interface{}{50, 100, 10, 9, 101, 0, -1, 50.5, int64(50)} {
 		tests[i] = Expect{
 			num,
 			false,
@@ -124,7 +196,7 @@ func TestRange(t *testing.T) {
 	// these are min/max with values swapped, so the min is the high
 	// and max is the low. rangeValidator.IsSatisfied() should ALWAYS
 	// result in false since val can never be greater than min and less
-	// than max when min > max
+	// than max when min > max, regardless of the numeric kind of val.
 	badValidators := []Range{
 		{Min{100}, Max{10}},
 		ValidRange(100, 10),
@@ -261,3 +333,329 @@ func TestEmail(t *testing.T) {
 		}
 	}
 }
+
+func TestStartsWith(t *testing.T) {
+	tests := []Expect{
+		{"https://example.com", true, "has prefix"},
+		{"http://example.com", false, "missing prefix"},
+		{42, false, "TypeOf(val) != string"},
+	}
+	for _, s := range []StartsWith{{"https://"}, ValidStartsWith("https://")} {
+		performTests(s, tests, t)
+	}
+
+	unicodeTests := []Expect{
+		{"火箭发射", true, "unicode prefix match"},
+		{"发射火箭", false, "unicode prefix mismatch"},
+	}
+	performTests(ValidStartsWith("火箭"), unicodeTests, t)
+}
+
+func TestEndsWith(t *testing.T) {
+	tests := []Expect{
+		{"photo.png", true, "has suffix"},
+		{"photo.jpg", false, "missing suffix"},
+		{42, false, "TypeOf(val) != string"},
+	}
+	for _, e := range []EndsWith{{".png"}, ValidEndsWith(".png")} {
+		performTests(e, tests, t)
+	}
+
+	unicodeTests := []Expect{
+		{"火箭发射", true, "unicode suffix match"},
+		{"发射火箭", false, "unicode suffix mismatch"},
+	}
+	performTests(ValidEndsWith("发射"), unicodeTests, t)
+}
+
+func TestContains(t *testing.T) {
+	tests := []Expect{
+		{"hello world", true, "contains substring"},
+		{"hello there", false, "missing substring"},
+		{42, false, "TypeOf(val) != string"},
+	}
+	for _, c := range []Contains{{"world"}, ValidContains("world")} {
+		performTests(c, tests, t)
+	}
+
+	unicodeTests := []Expect{
+		{"我喜欢火箭", true, "unicode substring match"},
+		{"我喜欢飞机", false, "unicode substring mismatch"},
+	}
+	performTests(ValidContains("火箭"), unicodeTests, t)
+}
+
+func TestExcludes(t *testing.T) {
+	tests := []Expect{
+		{"hello there", true, "does not contain substring"},
+		{"hello world", false, "contains excluded substring"},
+		{42, false, "TypeOf(val) != string"},
+	}
+	for _, e := range []Excludes{{"world"}, ValidExcludes("world")} {
+		performTests(e, tests, t)
+	}
+
+	unicodeTests := []Expect{
+		{"我喜欢飞机", true, "unicode substring absent"},
+		{"我喜欢火箭", false, "unicode substring present"},
+	}
+	performTests(ValidExcludes("火箭"), unicodeTests, t)
+}
+
+func TestURL(t *testing.T) {
+	tests := []Expect{
+		{"http://example.com", true, "http URL"},
+		{"https://example.com/path?q=1", true, "https URL with path and query"},
+		{"ftp://example.com", true, "ftp URL"},
+		{"example.com", false, "missing scheme"},
+		{"http://", false, "missing host"},
+		{"", false, "empty string"},
+		{42, false, "TypeOf(val) != string"},
+	}
+	for _, u := range []URL{{}, ValidURL()} {
+		performTests(u, tests, t)
+	}
+}
+
+func TestIP(t *testing.T) {
+	tests := []Expect{
+		{"192.168.1.1", true, "valid IPv4"},
+		{"::1", true, "valid IPv6"},
+		{"999.999.999.999", false, "invalid IPv4"},
+		{"not-an-ip", false, "not an IP"},
+	}
+	for _, ip := range []IP{{}, ValidIP()} {
+		performTests(ip, tests, t)
+	}
+
+	v4Tests := []Expect{
+		{"192.168.1.1", true, "valid IPv4"},
+		{"::1", false, "IPv6 is not IPv4"},
+	}
+	performTests(ValidIPv4(), v4Tests, t)
+
+	v6Tests := []Expect{
+		{"::1", true, "valid IPv6"},
+		{"192.168.1.1", false, "IPv4 is not IPv6"},
+	}
+	performTests(ValidIPv6(), v6Tests, t)
+}
+
+func TestCIDR(t *testing.T) {
+	tests := []Expect{
+		{"192.0.2.0/24", true, "valid IPv4 CIDR"},
+		{"2001:db8::/32", true, "valid IPv6 CIDR"},
+		{"192.0.2.0", false, "missing prefix length"},
+		{"not-a-cidr", false, "not a CIDR"},
+	}
+	for _, c := range []CIDR{{}, ValidCIDR()} {
+		performTests(c, tests, t)
+	}
+}
+
+func TestMAC(t *testing.T) {
+	tests := []Expect{
+		{"01:23:45:67:89:ab", true, "valid MAC"},
+		{"not-a-mac", false, "not a MAC"},
+	}
+	for _, m := range []MAC{{}, ValidMAC()} {
+		performTests(m, tests, t)
+	}
+}
+
+func TestUUID(t *testing.T) {
+	tests := []Expect{
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", true, "valid UUID"},
+		{"not-a-uuid", false, "not a UUID"},
+	}
+	for _, u := range []UUID{{}, ValidUUID()} {
+		performTests(u, tests, t)
+	}
+
+	v4Tests := []Expect{
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", true, "valid UUID v4"},
+		{"f47ac10b-58cc-3372-a567-0e02b2c3d479", false, "UUID v3, not v4"},
+	}
+	performTests(ValidUUID4(), v4Tests, t)
+}
+
+func TestISBN(t *testing.T) {
+	tests10 := []Expect{
+		{"0-306-40615-2", true, "valid ISBN-10 with hyphens"},
+		{"0306406152", true, "valid ISBN-10 without hyphens"},
+		{"0-306-40615-1", false, "bad checksum"},
+		{"not-an-isbn", false, "not an ISBN"},
+	}
+	for _, i := range []ISBN10{{}, ValidISBN10()} {
+		performTests(i, tests10, t)
+	}
+
+	tests13 := []Expect{
+		{"978-0-306-40615-7", true, "valid ISBN-13 with hyphens"},
+		{"9780306406157", true, "valid ISBN-13 without hyphens"},
+		{"978-0-306-40615-8", false, "bad checksum"},
+	}
+	for _, i := range []ISBN13{{}, ValidISBN13()} {
+		performTests(i, tests13, t)
+	}
+
+	testsEither := []Expect{
+		{"0-306-40615-2", true, "valid ISBN-10"},
+		{"978-0-306-40615-7", true, "valid ISBN-13"},
+		{"not-an-isbn", false, "not an ISBN"},
+	}
+	for _, i := range []ISBN{{}, ValidISBN()} {
+		performTests(i, testsEither, t)
+	}
+}
+
+func TestASCII(t *testing.T) {
+	tests := []Expect{
+		{"Testing 123!", true, "ASCII string"},
+		{"火箭", false, "non-ASCII string"},
+		{"", true, "empty string"},
+	}
+	for _, a := range []ASCII{{}, ValidASCII()} {
+		performTests(a, tests, t)
+	}
+
+	printableTests := []Expect{
+		{"Testing 123!", true, "printable ASCII"},
+		{"with\ttab", false, "contains control character"},
+		{"火箭", false, "non-ASCII string"},
+	}
+	for _, p := range []PrintableASCII{{}, ValidPrintableASCII()} {
+		performTests(p, printableTests, t)
+	}
+}
+
+func TestBase64(t *testing.T) {
+	tests := []Expect{
+		{"aGVsbG8=", true, "valid base64"},
+		{"aGVsbG8", false, "invalid padding"},
+		{"not base64!", false, "invalid characters"},
+		{"", false, "empty string"},
+	}
+	for _, b := range []Base64{{}, ValidBase64()} {
+		performTests(b, tests, t)
+	}
+}
+
+func TestDataURI(t *testing.T) {
+	tests := []Expect{
+		{"data:image/png;base64,aGVsbG8=", true, "valid data URI"},
+		{"data:text/plain;base64,aGVsbG8", false, "invalid base64 payload"},
+		{"not-a-data-uri", false, "not a data URI"},
+	}
+	for _, d := range []DataURI{{}, ValidDataURI()} {
+		performTests(d, tests, t)
+	}
+}
+
+func TestLatitude(t *testing.T) {
+	tests := []Expect{
+		{45.0, true, "valid latitude"},
+		{"45.0", true, "valid latitude string"},
+		{90, true, "boundary max"},
+		{-90, true, "boundary min"},
+		{91, false, "out of range"},
+		{"not-a-number", false, "not numeric"},
+	}
+	for _, l := range []Latitude{{}, ValidLatitude()} {
+		performTests(l, tests, t)
+	}
+}
+
+func TestLongitude(t *testing.T) {
+	tests := []Expect{
+		{120.0, true, "valid longitude"},
+		{"120.0", true, "valid longitude string"},
+		{180, true, "boundary max"},
+		{-180, true, "boundary min"},
+		{181, false, "out of range"},
+		{"not-a-number", false, "not numeric"},
+	}
+	for _, l := range []Longitude{{}, ValidLongitude()} {
+		performTests(l, tests, t)
+	}
+}
+
+func TestSSN(t *testing.T) {
+	tests := []Expect{
+		{"123-45-6789", true, "valid SSN with hyphens"},
+		{"123456789", true, "valid SSN without hyphens"},
+		{"000-45-6789", false, "area 000 is invalid"},
+		{"666-45-6789", false, "area 666 is invalid"},
+		{"123-00-6789", false, "group 00 is invalid"},
+		{"123-45-0000", false, "serial 0000 is invalid"},
+		{"not-an-ssn", false, "not an SSN"},
+	}
+	for _, s := range []SSN{{}, ValidSSN()} {
+		performTests(s, tests, t)
+	}
+}
+
+func TestAll(t *testing.T) {
+	tests := []Expect{
+		{15, true, "satisfies both Min and Max"},
+		{5, false, "fails Min"},
+		{25, false, "fails Max"},
+		{true, false, "TypeOf(val) != int"},
+	}
+	for _, all := range []Validator{
+		ValidAll(Min{10}, Max{20}),
+		&All{Validators: []Validator{Min{10}, Max{20}}},
+	} {
+		performTests(all, tests, t)
+	}
+}
+
+func TestAllDefaultMessage(t *testing.T) {
+	all := ValidAll(Min{10}, Max{20})
+
+	if all.IsSatisfied(5) {
+		t.Fatal("expected Min{10} to fail for 5")
+	}
+	if got, want := all.DefaultMessage(), (Min{10}).DefaultMessage(); got != want {
+		t.Errorf("DefaultMessage() = %q, want %q (the failing Min)", got, want)
+	}
+
+	if all.IsSatisfied(25) {
+		t.Fatal("expected Max{20} to fail for 25")
+	}
+	if got, want := all.DefaultMessage(), (Max{20}).DefaultMessage(); got != want {
+		t.Errorf("DefaultMessage() = %q, want %q (the failing Max)", got, want)
+	}
+
+	if !all.IsSatisfied(15) {
+		t.Fatal("expected 15 to satisfy Min{10} and Max{20}")
+	}
+}
+
+func TestAny(t *testing.T) {
+	tests := []Expect{
+		{5, true, "val < 10"},
+		{25, true, "val > 20"},
+		{15, false, "neither val < 10 nor val > 20"},
+	}
+	for _, any := range []Validator{
+		ValidAny(Max{10}, Min{20}),
+		&Any{Validators: []Validator{Max{10}, Min{20}}},
+	} {
+		performTests(any, tests, t)
+	}
+}
+
+func TestNot(t *testing.T) {
+	tests := []Expect{
+		{9, true, "val < min, so Not inverts to true"},
+		{10, false, "val == min, so Not inverts to false"},
+		{11, false, "val > min, so Not inverts to false"},
+	}
+	for _, not := range []Validator{
+		ValidNot(Min{10}),
+		Not{Min{10}},
+	} {
+		performTests(not, tests, t)
+	}
+}